@@ -0,0 +1,46 @@
+// Copyright (c) 2026 The Jaeger Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adaptive
+
+import "time"
+
+// Options holds configuration for the adaptive sampling strategy store.
+type Options struct {
+	// CalibrationInterval is how often observed span throughput is turned into new sampling
+	// probabilities.
+	CalibrationInterval time.Duration
+
+	// TargetSamplesPerSecond is the desired number of sampled traces per second for each
+	// service/operation bucket.
+	TargetSamplesPerSecond float64
+
+	// MinSamplingProbability is the lower bound applied to every computed probability.
+	MinSamplingProbability float64
+
+	// MaxSamplingProbability is the upper bound applied to every computed probability.
+	MaxSamplingProbability float64
+
+	// EWMASmoothingFactor (alpha) controls how much a newly calculated probability replaces the
+	// previous one: p_new = alpha*p_calculated + (1-alpha)*p_old. Values closer to 1 react
+	// faster to changes in traffic; values closer to 0 damp out short-lived spikes.
+	EWMASmoothingFactor float64
+
+	// DefaultSamplingProbability seeds services and operations that have not been observed yet.
+	DefaultSamplingProbability float64
+
+	// StateFile, if non-empty, persists computed probabilities to disk so that a restart does
+	// not throw away everything the store has learned.
+	StateFile string
+}
@@ -0,0 +1,312 @@
+// Copyright (c) 2026 The Jaeger Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package adaptive provides a strategy store that continuously adjusts per-service and
+// per-operation sampling probabilities to hit a target number of sampled traces per second,
+// instead of relying on the fixed rates configured in the static store.
+package adaptive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	ss "github.com/jaegertracing/jaeger/cmd/collector/app/sampling/strategystore"
+	"github.com/jaegertracing/jaeger/proto-gen/api_v2"
+)
+
+type operationKey struct {
+	service   string
+	operation string
+}
+
+// probabilities is an immutable snapshot of the currently computed sampling probabilities. A new
+// snapshot is built and swapped in wholesale on every calibration, mirroring how the static store
+// swaps its storedStrategies. It is computed in three tiers: an operation's own probability, the
+// probability calibrated for its service, and the global default, each one falling back to the
+// next when the more specific one has never been observed.
+type probabilities struct {
+	defaultProbability float64
+	services           map[string]float64
+	operations         map[operationKey]float64
+}
+
+type strategyStore struct {
+	options Options
+	logger  *zap.Logger
+
+	probabilities atomic.Value // holds *probabilities
+
+	mux           sync.Mutex
+	counts        map[operationKey]int64
+	serviceCounts map[string]int64
+
+	cancelFunc context.CancelFunc
+}
+
+// NewStrategyStore creates a strategy store that adapts sampling probabilities to observed span
+// throughput, calibrating periodically and persisting what it learns so restarts don't reset it.
+func NewStrategyStore(options Options, logger *zap.Logger) (ss.StrategyStore, error) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	h := &strategyStore{
+		options:       options,
+		logger:        logger,
+		counts:        make(map[operationKey]int64),
+		serviceCounts: make(map[string]int64),
+		cancelFunc:    cancelFunc,
+	}
+
+	initial := h.loadState()
+	if initial == nil {
+		initial = &probabilities{
+			defaultProbability: options.DefaultSamplingProbability,
+			services:           make(map[string]float64),
+			operations:         make(map[operationKey]float64),
+		}
+	}
+	h.probabilities.Store(initial)
+
+	if options.CalibrationInterval > 0 {
+		go h.runCalibrationLoop(ctx)
+	}
+	return h, nil
+}
+
+// RecordSpan is the metrics ingest hook: callers feed every observed span through it so the
+// span counts used by the next calibration reflect actual throughput, at both the
+// per-operation and per-service level. The counts cover one calibration interval only: they
+// are reset at each calibration, not kept as a window sliding across intervals.
+func (h *strategyStore) RecordSpan(service, operation string) {
+	key := operationKey{service: service, operation: operation}
+	h.mux.Lock()
+	h.counts[key]++
+	h.serviceCounts[service]++
+	h.mux.Unlock()
+}
+
+// GetSamplingStrategy implements StrategyStore#GetSamplingStrategy.
+func (h *strategyStore) GetSamplingStrategy(_ context.Context, serviceName string) (*api_v2.SamplingStrategyResponse, error) {
+	p := h.probabilities.Load().(*probabilities)
+
+	serviceDefault := p.defaultProbability
+	if probability, ok := p.services[serviceName]; ok {
+		serviceDefault = probability
+	}
+
+	perOperation := &api_v2.PerOperationSamplingStrategies{
+		DefaultSamplingProbability: serviceDefault,
+	}
+	for key, probability := range p.operations {
+		if key.service != serviceName {
+			continue
+		}
+		perOperation.PerOperationStrategies = append(perOperation.PerOperationStrategies,
+			&api_v2.OperationSamplingStrategy{
+				Operation: key.operation,
+				ProbabilisticSampling: &api_v2.ProbabilisticSamplingStrategy{
+					SamplingRate: probability,
+				},
+			})
+	}
+
+	return &api_v2.SamplingStrategyResponse{
+		StrategyType: api_v2.SamplingStrategyType_PROBABILISTIC,
+		ProbabilisticSampling: &api_v2.ProbabilisticSamplingStrategy{
+			SamplingRate: serviceDefault,
+		},
+		OperationSampling: perOperation,
+	}, nil
+}
+
+// Close stops the calibration loop.
+func (h *strategyStore) Close() {
+	h.cancelFunc()
+}
+
+func (h *strategyStore) runCalibrationLoop(ctx context.Context) {
+	ticker := time.NewTicker(h.options.CalibrationInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			h.calibrate()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// calibrate recomputes sampling probabilities from the span counts observed during the last
+// calibration interval:
+//
+//	newProbability = clamp(currentProbability * targetTPS / observedTPS)
+//
+// and then EWMA-smooths the result against the previous probability to avoid oscillation. This is
+// done once per service (using span counts aggregated across all of that service's operations)
+// and once per operation. Entities not observed this interval keep their last known probability;
+// an operation never observed at all inherits its service's calibrated default, and a service
+// never observed at all inherits the global default.
+func (h *strategyStore) calibrate() {
+	h.mux.Lock()
+	counts := h.counts
+	serviceCounts := h.serviceCounts
+	h.counts = make(map[operationKey]int64)
+	h.serviceCounts = make(map[string]int64)
+	h.mux.Unlock()
+
+	prev := h.probabilities.Load().(*probabilities)
+	next := &probabilities{
+		defaultProbability: prev.defaultProbability,
+		services:           make(map[string]float64, len(prev.services)+len(serviceCounts)),
+		operations:         make(map[operationKey]float64, len(prev.operations)+len(counts)),
+	}
+	for service, probability := range prev.services {
+		next.services[service] = probability
+	}
+	for key, probability := range prev.operations {
+		next.operations[key] = probability
+	}
+
+	interval := h.options.CalibrationInterval.Seconds()
+	alpha := h.options.EWMASmoothingFactor
+
+	for service, count := range serviceCounts {
+		observedTPS := float64(count) / interval
+		if observedTPS <= 0 {
+			continue
+		}
+		currentProbability, ok := next.services[service]
+		if !ok {
+			currentProbability = prev.defaultProbability
+		}
+		calculated := h.clamp(currentProbability * h.options.TargetSamplesPerSecond / observedTPS)
+		next.services[service] = alpha*calculated + (1-alpha)*currentProbability
+	}
+
+	for key, count := range counts {
+		observedTPS := float64(count) / interval
+		if observedTPS <= 0 {
+			continue
+		}
+		currentProbability, ok := next.operations[key]
+		if !ok {
+			currentProbability, ok = next.services[key.service]
+			if !ok {
+				currentProbability = prev.defaultProbability
+			}
+		}
+		calculated := h.clamp(currentProbability * h.options.TargetSamplesPerSecond / observedTPS)
+		next.operations[key] = alpha*calculated + (1-alpha)*currentProbability
+	}
+
+	h.probabilities.Store(next)
+	if err := h.persistState(next); err != nil {
+		h.logger.Error("failed to persist adaptive sampling probabilities", zap.Error(err))
+	}
+}
+
+func (h *strategyStore) clamp(probability float64) float64 {
+	if probability < h.options.MinSamplingProbability {
+		return h.options.MinSamplingProbability
+	}
+	if probability > h.options.MaxSamplingProbability {
+		return h.options.MaxSamplingProbability
+	}
+	return probability
+}
+
+// persistedState is the on-disk representation of probabilities, used so restarts do not throw
+// away everything the store has learned.
+type persistedState struct {
+	DefaultProbability float64                   `json:"default_probability"`
+	Services           []persistedServiceEntry   `json:"services"`
+	Operations         []persistedOperationEntry `json:"operations"`
+}
+
+type persistedServiceEntry struct {
+	Service     string  `json:"service"`
+	Probability float64 `json:"probability"`
+}
+
+type persistedOperationEntry struct {
+	Service     string  `json:"service"`
+	Operation   string  `json:"operation"`
+	Probability float64 `json:"probability"`
+}
+
+func (h *strategyStore) persistState(p *probabilities) error {
+	if h.options.StateFile == "" {
+		return nil
+	}
+	state := persistedState{DefaultProbability: p.defaultProbability}
+	for service, probability := range p.services {
+		state.Services = append(state.Services, persistedServiceEntry{
+			Service:     service,
+			Probability: probability,
+		})
+	}
+	for key, probability := range p.operations {
+		state.Operations = append(state.Operations, persistedOperationEntry{
+			Service:     key.service,
+			Operation:   key.operation,
+			Probability: probability,
+		})
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to marshal adaptive sampling state: %w", err)
+	}
+	tmp := h.options.StateFile + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write adaptive sampling state: %w", err)
+	}
+	return os.Rename(tmp, h.options.StateFile)
+}
+
+func (h *strategyStore) loadState() *probabilities {
+	if h.options.StateFile == "" {
+		return nil
+	}
+	data, err := os.ReadFile(filepath.Clean(h.options.StateFile))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			h.logger.Warn("failed to read persisted adaptive sampling state", zap.Error(err))
+		}
+		return nil
+	}
+	var state persistedState
+	if err := json.Unmarshal(data, &state); err != nil {
+		h.logger.Warn("failed to unmarshal persisted adaptive sampling state", zap.Error(err))
+		return nil
+	}
+	p := &probabilities{
+		defaultProbability: state.DefaultProbability,
+		services:           make(map[string]float64, len(state.Services)),
+		operations:         make(map[operationKey]float64, len(state.Operations)),
+	}
+	for _, entry := range state.Services {
+		p.services[entry.Service] = entry.Probability
+	}
+	for _, entry := range state.Operations {
+		p.operations[operationKey{service: entry.Service, operation: entry.Operation}] = entry.Probability
+	}
+	return p
+}
@@ -0,0 +1,185 @@
+// Copyright (c) 2026 The Jaeger Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adaptive
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewStrategyStoreZeroCalibrationInterval(t *testing.T) {
+	// A zero CalibrationInterval must not panic (time.NewTicker rejects non-positive
+	// durations); the calibration loop should simply never be started.
+	store, err := NewStrategyStore(Options{DefaultSamplingProbability: 0.1}, zap.NewNop())
+	require.NoError(t, err)
+	defer store.Close()
+}
+
+func TestClamp(t *testing.T) {
+	h := &strategyStore{
+		options: Options{
+			MinSamplingProbability: 0.01,
+			MaxSamplingProbability: 0.5,
+		},
+	}
+	tests := []struct {
+		name        string
+		probability float64
+		expected    float64
+	}{
+		{name: "below min", probability: 0.001, expected: 0.01},
+		{name: "above max", probability: 0.9, expected: 0.5},
+		{name: "within range", probability: 0.2, expected: 0.2},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, h.clamp(test.probability))
+		})
+	}
+}
+
+func TestCalibrate(t *testing.T) {
+	h := &strategyStore{
+		options: Options{
+			CalibrationInterval:    time.Second,
+			TargetSamplesPerSecond: 10,
+			MinSamplingProbability: 0.001,
+			MaxSamplingProbability: 1,
+			EWMASmoothingFactor:    1, // disable smoothing so expectations are exact
+		},
+		logger: zap.NewNop(),
+		counts: map[operationKey]int64{
+			{service: "svc", operation: "op"}: 20, // observed 20 TPS, want 10 -> halve probability
+		},
+	}
+	h.probabilities.Store(&probabilities{
+		defaultProbability: 0.5,
+		operations:         map[operationKey]float64{},
+	})
+
+	h.calibrate()
+
+	next := h.probabilities.Load().(*probabilities)
+	assert.InDelta(t, 0.25, next.operations[operationKey{service: "svc", operation: "op"}], 1e-9)
+	assert.Empty(t, h.counts, "counts must be reset after calibration")
+}
+
+func TestCalibrateIgnoresUnobservedOperations(t *testing.T) {
+	h := &strategyStore{
+		options: Options{
+			CalibrationInterval:    time.Second,
+			TargetSamplesPerSecond: 10,
+			MinSamplingProbability: 0.001,
+			MaxSamplingProbability: 1,
+			EWMASmoothingFactor:    1,
+		},
+		logger: zap.NewNop(),
+		counts: map[operationKey]int64{
+			{service: "svc", operation: "zero"}: 0,
+		},
+	}
+	h.probabilities.Store(&probabilities{
+		defaultProbability: 0.5,
+		operations: map[operationKey]float64{
+			{service: "svc", operation: "zero"}: 0.5,
+		},
+	})
+
+	h.calibrate()
+
+	next := h.probabilities.Load().(*probabilities)
+	assert.Equal(t, 0.5, next.operations[operationKey{service: "svc", operation: "zero"}])
+}
+
+func TestCalibrateComputesPerServiceDefault(t *testing.T) {
+	h := &strategyStore{
+		options: Options{
+			CalibrationInterval:    time.Second,
+			TargetSamplesPerSecond: 10,
+			MinSamplingProbability: 0.001,
+			MaxSamplingProbability: 1,
+			EWMASmoothingFactor:    1,
+		},
+		logger:        zap.NewNop(),
+		counts:        map[operationKey]int64{},
+		serviceCounts: map[string]int64{"svc": 20}, // observed 20 TPS, want 10 -> halve probability
+	}
+	h.probabilities.Store(&probabilities{
+		defaultProbability: 0.5,
+		services:           map[string]float64{},
+		operations:         map[operationKey]float64{},
+	})
+
+	h.calibrate()
+
+	next := h.probabilities.Load().(*probabilities)
+	assert.InDelta(t, 0.25, next.services["svc"], 1e-9)
+	assert.Empty(t, h.serviceCounts, "service counts must be reset after calibration")
+}
+
+func TestCalibrateNewOperationInheritsServiceDefaultOverGlobalDefault(t *testing.T) {
+	h := &strategyStore{
+		options: Options{
+			CalibrationInterval:    time.Second,
+			TargetSamplesPerSecond: 10,
+			MinSamplingProbability: 0.001,
+			MaxSamplingProbability: 1,
+			EWMASmoothingFactor:    1,
+		},
+		logger: zap.NewNop(),
+		counts: map[operationKey]int64{
+			{service: "svc", operation: "new-op"}: 10, // observed == target -> probability unchanged
+		},
+		serviceCounts: map[string]int64{},
+	}
+	h.probabilities.Store(&probabilities{
+		defaultProbability: 0.01, // global default, should NOT be used
+		services: map[string]float64{
+			"svc": 0.8, // this service's own calibrated default
+		},
+		operations: map[operationKey]float64{},
+	})
+
+	h.calibrate()
+
+	next := h.probabilities.Load().(*probabilities)
+	assert.InDelta(t, 0.8, next.operations[operationKey{service: "svc", operation: "new-op"}], 1e-9)
+}
+
+func TestGetSamplingStrategyUsesPerServiceDefault(t *testing.T) {
+	h := &strategyStore{logger: zap.NewNop()}
+	h.probabilities.Store(&probabilities{
+		defaultProbability: 0.01,
+		services: map[string]float64{
+			"svc": 0.6,
+		},
+		operations: map[operationKey]float64{},
+	})
+
+	resp, err := h.GetSamplingStrategy(context.Background(), "svc")
+	require.NoError(t, err)
+	assert.Equal(t, 0.6, resp.ProbabilisticSampling.SamplingRate)
+	assert.Equal(t, 0.6, resp.OperationSampling.DefaultSamplingProbability)
+
+	// An unobserved service falls back to the global default.
+	resp, err = h.GetSamplingStrategy(context.Background(), "other-svc")
+	require.NoError(t, err)
+	assert.Equal(t, 0.01, resp.ProbabilisticSampling.SamplingRate)
+}
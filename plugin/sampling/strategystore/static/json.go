@@ -0,0 +1,83 @@
+// Copyright (c) 2018 The Jaeger Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package static
+
+import (
+	"github.com/jaegertracing/jaeger/proto-gen/api_v2"
+)
+
+const (
+	samplerTypeProbabilistic = "probabilistic"
+	samplerTypeRateLimiting  = "ratelimiting"
+
+	defaultSamplingProbability = 0.001
+)
+
+// strategies is the top level object unmarshalled from the sampling strategies JSON document.
+type strategies struct {
+	DefaultStrategy   *serviceStrategy   `json:"default_strategy"`
+	ServiceStrategies []*serviceStrategy `json:"service_strategies"`
+}
+
+// serviceStrategy is the sampling strategy configured for a single service.
+type serviceStrategy struct {
+	strategy
+	Service             string               `json:"service"`
+	OperationStrategies []*operationStrategy `json:"operation_strategies"`
+	Rules               []*rule              `json:"rules"`
+}
+
+// operationStrategy is the sampling strategy configured for a single operation of a service.
+type operationStrategy struct {
+	strategy
+	Operation string `json:"operation"`
+}
+
+// rule is a single tag-matching predicate evaluated against a span's tags. Rules are evaluated
+// top-to-bottom with the first match winning; the resulting strategy applies in place of the
+// regular per-operation/service/default cascade.
+type rule struct {
+	strategy
+	Tag      string  `json:"tag"`
+	Operator string  `json:"operator"` // one of: equals, glob, numeric_range
+	Value    string  `json:"value"`
+	Min      float64 `json:"min"`
+	Max      float64 `json:"max"`
+}
+
+// strategy holds the sampler type and parameter shared by the default, service and operation
+// level strategies.
+type strategy struct {
+	Type  string  `json:"type"`
+	Param float64 `json:"param"`
+}
+
+func defaultStrategies() *storedStrategies {
+	return &storedStrategies{
+		serviceStrategies:          make(map[string]*api_v2.SamplingStrategyResponse),
+		defaultStrategy:            defaultStrategyResponse(),
+		serviceRules:               make(map[string][]*parsedRule),
+		serviceOperationRateLimits: make(map[string]map[string]*api_v2.SamplingStrategyResponse),
+	}
+}
+
+func defaultStrategyResponse() *api_v2.SamplingStrategyResponse {
+	return &api_v2.SamplingStrategyResponse{
+		StrategyType: api_v2.SamplingStrategyType_PROBABILISTIC,
+		ProbabilisticSampling: &api_v2.ProbabilisticSamplingStrategy{
+			SamplingRate: defaultSamplingProbability,
+		},
+	}
+}
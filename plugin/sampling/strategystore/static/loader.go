@@ -0,0 +1,271 @@
+// Copyright (c) 2026 The Jaeger Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package static
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+
+	"github.com/jaegertracing/jaeger/proto-gen/api_v2"
+)
+
+// StrategyLoader fetches the raw sampling strategies JSON document from a particular backend.
+// version is an opaque token identifying the returned data; implementations should return the
+// same version (and may omit data) when the content has not changed since lastVersion was
+// obtained from a previous call, so the reload loop can skip reparsing and swapping in a no-op
+// update.
+type StrategyLoader interface {
+	Load(ctx context.Context, lastVersion string) (data []byte, version string, err error)
+}
+
+// StrategyLoaderFactory creates a StrategyLoader for a source URI handled by a given scheme.
+type StrategyLoaderFactory func(source string) (StrategyLoader, error)
+
+var strategyLoaderFactoriesMux sync.Mutex
+var strategyLoaderFactories = map[string]StrategyLoaderFactory{
+	"file":   newFileStrategyLoader,
+	"http":   newHTTPStrategyLoader,
+	"https":  newHTTPStrategyLoader,
+	"grpc":   newGRPCStrategyLoader,
+	"s3":     newUnsupportedStrategyLoader("s3"),
+	"consul": newUnsupportedStrategyLoader("consul"),
+	"etcd":   newUnsupportedStrategyLoader("etcd"),
+}
+
+// RegisterStrategyLoader adds or replaces the StrategyLoader factory used for the given URI
+// scheme, letting deployments plug in additional backends (e.g. their own config service)
+// without forking this package.
+func RegisterStrategyLoader(scheme string, factory StrategyLoaderFactory) {
+	strategyLoaderFactoriesMux.Lock()
+	defer strategyLoaderFactoriesMux.Unlock()
+	strategyLoaderFactories[scheme] = factory
+}
+
+// schemeOf returns the URI scheme of source, defaulting to "file" for bare filesystem paths.
+func schemeOf(source string) string {
+	if u, err := url.Parse(source); err == nil && u.Scheme != "" && u.Host != "" {
+		return strings.ToLower(u.Scheme)
+	}
+	return "file"
+}
+
+func isURL(source string) bool {
+	return schemeOf(source) != "file"
+}
+
+// newStrategyLoader resolves source to a StrategyLoader based on its URI scheme.
+func newStrategyLoader(source string) (StrategyLoader, error) {
+	scheme := schemeOf(source)
+
+	strategyLoaderFactoriesMux.Lock()
+	factory, ok := strategyLoaderFactories[scheme]
+	strategyLoaderFactoriesMux.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("no sampling strategy loader registered for scheme %q", scheme)
+	}
+	return factory(source)
+}
+
+func newUnsupportedStrategyLoader(scheme string) StrategyLoaderFactory {
+	return func(string) (StrategyLoader, error) {
+		return nil, fmt.Errorf("%s sampling strategy loader is not implemented yet", scheme)
+	}
+}
+
+// fileStrategyLoader reads the strategies document from a local file.
+type fileStrategyLoader struct {
+	path string
+}
+
+func newFileStrategyLoader(source string) (StrategyLoader, error) {
+	path := source
+	if u, err := url.Parse(source); err == nil && u.Scheme == "file" {
+		path = u.Path
+	}
+	return &fileStrategyLoader{path: path}, nil
+}
+
+func (l *fileStrategyLoader) Load(_ context.Context, lastVersion string) ([]byte, string, error) {
+	data, err := os.ReadFile(filepath.Clean(l.path))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read strategies file %s: %w", l.path, err)
+	}
+	version := fmt.Sprintf("%x", sha256.Sum256(data))
+	if version == lastVersion {
+		return nil, version, nil
+	}
+	return data, version, nil
+}
+
+// httpStrategyLoader downloads the strategies document over HTTP(S), using ETag/Last-Modified
+// to issue conditional requests once it has seen a successful response.
+type httpStrategyLoader struct {
+	url string
+
+	mux          sync.Mutex
+	etag         string
+	lastModified string
+	lastBody     []byte
+}
+
+func newHTTPStrategyLoader(source string) (StrategyLoader, error) {
+	return &httpStrategyLoader{url: source}, nil
+}
+
+func (l *httpStrategyLoader) Load(ctx context.Context, lastVersion string) ([]byte, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, l.url, nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create sampling strategies request: %w", err)
+	}
+
+	l.mux.Lock()
+	etag, lastModified, lastBody := l.etag, l.lastModified, l.lastBody
+	l.mux.Unlock()
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	if lastModified != "" {
+		req.Header.Set("If-Modified-Since", lastModified)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to download sampling strategies: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return lastBody, lastVersion, nil
+	}
+
+	buf := new(bytes.Buffer)
+	if _, err = buf.ReadFrom(resp.Body); err != nil {
+		return nil, "", fmt.Errorf("failed to read sampling strategies HTTP response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusServiceUnavailable {
+		return nullJSON, "unavailable", nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf(
+			"receiving %s while downloading strategies file: %s",
+			resp.Status,
+			buf.String(),
+		)
+	}
+
+	body := buf.Bytes()
+	version := fmt.Sprintf("%x", sha256.Sum256(body))
+
+	l.mux.Lock()
+	l.etag = resp.Header.Get("ETag")
+	l.lastModified = resp.Header.Get("Last-Modified")
+	l.lastBody = body
+	l.mux.Unlock()
+
+	return body, version, nil
+}
+
+// grpcStrategyLoader fetches strategies for a single service from a remote SamplingManager gRPC
+// service, so collectors can take their strategies from a central control plane instead of an
+// HTTP endpoint. It is called on each reload poll; the control plane cannot push updates to it.
+type grpcStrategyLoader struct {
+	serviceName string
+	client      api_v2.SamplingManagerClient
+	conn        *grpc.ClientConn
+}
+
+// newGRPCStrategyLoader dials a grpc://host:port/serviceName source, where the path names the
+// service whose strategy should be fetched.
+func newGRPCStrategyLoader(source string) (StrategyLoader, error) {
+	u, err := url.Parse(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse grpc sampling strategy source %s: %w", source, err)
+	}
+	conn, err := grpc.Dial(u.Host, grpc.WithInsecure())
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial sampling manager at %s: %w", u.Host, err)
+	}
+	return &grpcStrategyLoader{
+		serviceName: strings.TrimPrefix(u.Path, "/"),
+		client:      api_v2.NewSamplingManagerClient(conn),
+		conn:        conn,
+	}, nil
+}
+
+func (l *grpcStrategyLoader) Load(ctx context.Context, lastVersion string) ([]byte, string, error) {
+	resp, err := l.client.GetSamplingStrategy(ctx, &api_v2.SamplingStrategyParameters{ServiceName: l.serviceName})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to fetch sampling strategies over gRPC: %w", err)
+	}
+
+	data, err := json.Marshal(&strategies{
+		ServiceStrategies: []*serviceStrategy{responseToServiceStrategy(l.serviceName, resp)},
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to marshal sampling strategies response: %w", err)
+	}
+
+	version := fmt.Sprintf("%x", sha256.Sum256(data))
+	if version == lastVersion {
+		return nil, version, nil
+	}
+	return data, version, nil
+}
+
+func (l *grpcStrategyLoader) Close() error {
+	return l.conn.Close()
+}
+
+// responseToServiceStrategy converts an already-resolved SamplingStrategyResponse, as served by
+// a central SamplingManager, back into the serviceStrategy JSON shape this package already knows
+// how to parse, so it can flow through the regular parseStrategies pipeline. Per-operation
+// strategies set by the control plane are preserved; dropping them would silently revert
+// operations back to the service default on every refresh.
+func responseToServiceStrategy(serviceName string, resp *api_v2.SamplingStrategyResponse) *serviceStrategy {
+	s := &serviceStrategy{Service: serviceName}
+	if resp.StrategyType == api_v2.SamplingStrategyType_RATE_LIMITING && resp.RateLimitingSampling != nil {
+		s.Type = samplerTypeRateLimiting
+		s.Param = float64(resp.RateLimitingSampling.MaxTracesPerSecond)
+	} else {
+		s.Type = samplerTypeProbabilistic
+		if resp.ProbabilisticSampling != nil {
+			s.Param = resp.ProbabilisticSampling.SamplingRate
+		}
+	}
+
+	if resp.OperationSampling != nil {
+		for _, op := range resp.OperationSampling.PerOperationStrategies {
+			opStrategy := &operationStrategy{Operation: op.Operation}
+			opStrategy.Type = samplerTypeProbabilistic
+			if op.ProbabilisticSampling != nil {
+				opStrategy.Param = op.ProbabilisticSampling.SamplingRate
+			}
+			s.OperationStrategies = append(s.OperationStrategies, opStrategy)
+		}
+	}
+	return s
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2026 The Jaeger Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package static
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jaegertracing/jaeger/proto-gen/api_v2"
+)
+
+func TestSchemeOf(t *testing.T) {
+	tests := []struct {
+		source   string
+		expected string
+	}{
+		{source: "/tmp/strategies.json", expected: "file"},
+		{source: "strategies.json", expected: "file"},
+		{source: "http://localhost:1234/strategies.json", expected: "http"},
+		{source: "https://localhost:1234/strategies.json", expected: "https"},
+		{source: "grpc://localhost:1234/my-service", expected: "grpc"},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			assert.Equal(t, test.expected, schemeOf(test.source))
+		})
+	}
+}
+
+func TestNewStrategyLoaderUnimplementedScheme(t *testing.T) {
+	_, err := newStrategyLoader("consul://localhost:8500/strategies")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not implemented")
+}
+
+func TestRegisterStrategyLoader(t *testing.T) {
+	called := false
+	RegisterStrategyLoader("custom", func(source string) (StrategyLoader, error) {
+		called = true
+		return &fileStrategyLoader{path: source}, nil
+	})
+
+	_, err := newStrategyLoader("custom://somewhere")
+	require.NoError(t, err)
+	assert.True(t, called)
+}
+
+func TestFileStrategyLoaderSkipsUnchangedContent(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "strategies.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"default_strategy":{"type":"probabilistic","param":1}}`), 0o600))
+
+	loader, err := newFileStrategyLoader(path)
+	require.NoError(t, err)
+
+	data, version, err := loader.Load(context.Background(), "")
+	require.NoError(t, err)
+	assert.NotEmpty(t, data)
+	assert.NotEmpty(t, version)
+
+	// Loading again with the same version should report no new data.
+	data, sameVersion, err := loader.Load(context.Background(), version)
+	require.NoError(t, err)
+	assert.Nil(t, data)
+	assert.Equal(t, version, sameVersion)
+}
+
+func TestHTTPStrategyLoaderConditionalRequest(t *testing.T) {
+	const body = `{"default_strategy":{"type":"probabilistic","param":1}}`
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	loader, err := newHTTPStrategyLoader(server.URL)
+	require.NoError(t, err)
+
+	data, version, err := loader.Load(context.Background(), "")
+	require.NoError(t, err)
+	assert.Equal(t, body, string(data))
+
+	// Second call should send If-None-Match and get back the cached body on a 304.
+	data, sameVersion, err := loader.Load(context.Background(), version)
+	require.NoError(t, err)
+	assert.Equal(t, body, string(data))
+	assert.Equal(t, version, sameVersion)
+	assert.Equal(t, 2, requests)
+}
+
+func TestHTTPStrategyLoaderServiceUnavailable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	loader, err := newHTTPStrategyLoader(server.URL)
+	require.NoError(t, err)
+
+	data, version, err := loader.Load(context.Background(), "")
+	require.NoError(t, err)
+	assert.Equal(t, nullJSON, data)
+	assert.Equal(t, "unavailable", version)
+}
+
+func TestResponseToServiceStrategyPreservesOperationSampling(t *testing.T) {
+	resp := &api_v2.SamplingStrategyResponse{
+		StrategyType: api_v2.SamplingStrategyType_PROBABILISTIC,
+		ProbabilisticSampling: &api_v2.ProbabilisticSamplingStrategy{
+			SamplingRate: 0.5,
+		},
+		OperationSampling: &api_v2.PerOperationSamplingStrategies{
+			DefaultSamplingProbability: 0.5,
+			PerOperationStrategies: []*api_v2.OperationSamplingStrategy{
+				{
+					Operation: "op1",
+					ProbabilisticSampling: &api_v2.ProbabilisticSamplingStrategy{
+						SamplingRate: 0.1,
+					},
+				},
+			},
+		},
+	}
+
+	s := responseToServiceStrategy("svc", resp)
+
+	require.Len(t, s.OperationStrategies, 1)
+	assert.Equal(t, "op1", s.OperationStrategies[0].Operation)
+	assert.Equal(t, samplerTypeProbabilistic, s.OperationStrategies[0].Type)
+	assert.Equal(t, 0.1, s.OperationStrategies[0].Param)
+}
+
+func TestResponseToServiceStrategyRateLimiting(t *testing.T) {
+	resp := &api_v2.SamplingStrategyResponse{
+		StrategyType: api_v2.SamplingStrategyType_RATE_LIMITING,
+		RateLimitingSampling: &api_v2.RateLimitingSamplingStrategy{
+			MaxTracesPerSecond: 5,
+		},
+	}
+
+	s := responseToServiceStrategy("svc", resp)
+
+	assert.Equal(t, samplerTypeRateLimiting, s.Type)
+	assert.Equal(t, 5.0, s.Param)
+}
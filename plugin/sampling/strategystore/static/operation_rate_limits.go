@@ -0,0 +1,59 @@
+// Copyright (c) 2026 The Jaeger Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package static
+
+import (
+	"context"
+
+	"github.com/jaegertracing/jaeger/proto-gen/api_v2"
+)
+
+// parseOperationRateLimits picks out the rate-limiting strategies configured for individual
+// operations. These have no home on api_v2.OperationSamplingStrategy (only ProbabilisticSampling
+// exists there), so they are kept in this package-local form and served through
+// GetSamplingStrategyForOperation instead of riding along on the regular wire response.
+func (h *strategyStore) parseOperationRateLimits(operationStrategies []*operationStrategy) map[string]*api_v2.SamplingStrategyResponse {
+	var limits map[string]*api_v2.SamplingStrategyResponse
+	for _, operationStrategy := range operationStrategies {
+		s := h.parseStrategy(&operationStrategy.strategy)
+		if s.StrategyType != api_v2.SamplingStrategyType_RATE_LIMITING {
+			continue
+		}
+		if limits == nil {
+			limits = make(map[string]*api_v2.SamplingStrategyResponse)
+		}
+		limits[operationStrategy.Operation] = s
+	}
+	return limits
+}
+
+// GetSamplingStrategyForOperation returns the rate-limiting strategy configured for a specific
+// operation of serviceName, checking the service's own configuration first and then the default
+// strategy's. If no rate limit is configured for the operation, it falls back to the regular
+// service/default cascade via GetSamplingStrategy, whose response carries a probabilistic
+// per-operation strategy instead.
+//
+// TODO: promote this to the ss.StrategyStore interface once that package can express
+// operation-aware lookups; until then it is only reachable through this concrete type.
+func (h *strategyStore) GetSamplingStrategyForOperation(ctx context.Context, serviceName, operation string) (*api_v2.SamplingStrategyResponse, error) {
+	snapshot := h.storedStrategies.Load().(*storedStrategies)
+	if s, ok := snapshot.serviceOperationRateLimits[serviceName][operation]; ok {
+		return s, nil
+	}
+	if s, ok := snapshot.defaultOperationRateLimits[operation]; ok {
+		return s, nil
+	}
+	return h.GetSamplingStrategy(ctx, serviceName)
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2026 The Jaeger Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package static
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/jaegertracing/jaeger/proto-gen/api_v2"
+)
+
+func TestGetSamplingStrategyForOperationUsesServiceRateLimit(t *testing.T) {
+	h := &strategyStore{logger: zap.NewNop()}
+	h.storedStrategies.Store(&storedStrategies{
+		defaultStrategy: defaultStrategyResponse(),
+		serviceOperationRateLimits: map[string]map[string]*api_v2.SamplingStrategyResponse{
+			"svc": {
+				"op": {
+					StrategyType: api_v2.SamplingStrategyType_RATE_LIMITING,
+					RateLimitingSampling: &api_v2.RateLimitingSamplingStrategy{
+						MaxTracesPerSecond: 3,
+					},
+				},
+			},
+		},
+	})
+
+	resp, err := h.GetSamplingStrategyForOperation(context.Background(), "svc", "op")
+	require.NoError(t, err)
+	require.NotNil(t, resp.RateLimitingSampling)
+	assert.EqualValues(t, 3, resp.RateLimitingSampling.MaxTracesPerSecond)
+}
+
+func TestGetSamplingStrategyForOperationFallsBackToDefault(t *testing.T) {
+	h := &strategyStore{logger: zap.NewNop()}
+	h.storedStrategies.Store(&storedStrategies{
+		defaultStrategy: defaultStrategyResponse(),
+		defaultOperationRateLimits: map[string]*api_v2.SamplingStrategyResponse{
+			"op": {
+				StrategyType: api_v2.SamplingStrategyType_RATE_LIMITING,
+				RateLimitingSampling: &api_v2.RateLimitingSamplingStrategy{
+					MaxTracesPerSecond: 7,
+				},
+			},
+		},
+		serviceOperationRateLimits: map[string]map[string]*api_v2.SamplingStrategyResponse{},
+	})
+
+	resp, err := h.GetSamplingStrategyForOperation(context.Background(), "svc", "op")
+	require.NoError(t, err)
+	require.NotNil(t, resp.RateLimitingSampling)
+	assert.EqualValues(t, 7, resp.RateLimitingSampling.MaxTracesPerSecond)
+}
+
+func TestGetSamplingStrategyForOperationFallsBackToCascade(t *testing.T) {
+	h := &strategyStore{logger: zap.NewNop()}
+	serviceResp := &api_v2.SamplingStrategyResponse{
+		StrategyType: api_v2.SamplingStrategyType_PROBABILISTIC,
+		ProbabilisticSampling: &api_v2.ProbabilisticSamplingStrategy{
+			SamplingRate: 0.75,
+		},
+	}
+	h.storedStrategies.Store(&storedStrategies{
+		defaultStrategy: defaultStrategyResponse(),
+		serviceStrategies: map[string]*api_v2.SamplingStrategyResponse{
+			"svc": serviceResp,
+		},
+		serviceOperationRateLimits: map[string]map[string]*api_v2.SamplingStrategyResponse{},
+	})
+
+	resp, err := h.GetSamplingStrategyForOperation(context.Background(), "svc", "op")
+	require.NoError(t, err)
+	assert.Same(t, serviceResp, resp)
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2026 The Jaeger Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package static
+
+import (
+	"context"
+	"path/filepath"
+	"strconv"
+
+	"github.com/jaegertracing/jaeger/proto-gen/api_v2"
+)
+
+const (
+	ruleOperatorEquals       = "equals"
+	ruleOperatorGlob         = "glob"
+	ruleOperatorNumericRange = "numeric_range"
+)
+
+// parsedRule is the strategy store's own representation of a tag-matching rule. It is not part of
+// api_v2.SamplingStrategyResponse, which has no field for rules, so matching against it is only
+// ever done locally by GetSamplingStrategyForSpan rather than being exposed over the wire.
+type parsedRule struct {
+	tag      string
+	operator string
+	value    string
+	min      float64
+	max      float64
+
+	strategy *api_v2.SamplingStrategyResponse
+}
+
+// parseRules converts the JSON rule definitions of a service strategy into parsedRules.
+func (h *strategyStore) parseRules(rules []*rule) []*parsedRule {
+	if len(rules) == 0 {
+		return nil
+	}
+	parsed := make([]*parsedRule, 0, len(rules))
+	for _, r := range rules {
+		parsed = append(parsed, &parsedRule{
+			tag:      r.Tag,
+			operator: r.Operator,
+			value:    r.Value,
+			min:      r.Min,
+			max:      r.Max,
+			strategy: h.parseStrategy(&r.strategy),
+		})
+	}
+	return parsed
+}
+
+// GetSamplingStrategyForSpan evaluates the rules configured for serviceName against the given
+// span tags, top-to-bottom, and returns the first match's strategy. If serviceName has no rules
+// of its own, the default strategy's rules are evaluated instead; if no rule matches, it falls
+// back to the regular per-operation/service/default cascade via GetSamplingStrategy.
+//
+// TODO: promote this to the ss.StrategyStore interface once that package can express rule-aware
+// lookups; until then it is only reachable through this concrete type.
+func (h *strategyStore) GetSamplingStrategyForSpan(ctx context.Context, serviceName string, tags map[string]string) (*api_v2.SamplingStrategyResponse, error) {
+	snapshot := h.storedStrategies.Load().(*storedStrategies)
+	rules := snapshot.serviceRules[serviceName]
+	if len(rules) == 0 {
+		rules = snapshot.defaultRules
+	}
+	for _, r := range rules {
+		if ruleMatches(r, tags) {
+			return r.strategy, nil
+		}
+	}
+	return h.GetSamplingStrategy(ctx, serviceName)
+}
+
+func ruleMatches(r *parsedRule, tags map[string]string) bool {
+	value, ok := tags[r.tag]
+	if !ok {
+		return false
+	}
+	switch r.operator {
+	case ruleOperatorEquals:
+		return value == r.value
+	case ruleOperatorGlob:
+		matched, err := filepath.Match(r.value, value)
+		return err == nil && matched
+	case ruleOperatorNumericRange:
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return false
+		}
+		return n >= r.min && n <= r.max
+	default:
+		return false
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2026 The Jaeger Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package static
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/jaegertracing/jaeger/proto-gen/api_v2"
+)
+
+func TestRuleMatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     *parsedRule
+		tags     map[string]string
+		expected bool
+	}{
+		{
+			name:     "equals match",
+			rule:     &parsedRule{tag: "http.method", operator: ruleOperatorEquals, value: "GET"},
+			tags:     map[string]string{"http.method": "GET"},
+			expected: true,
+		},
+		{
+			name:     "equals mismatch",
+			rule:     &parsedRule{tag: "http.method", operator: ruleOperatorEquals, value: "GET"},
+			tags:     map[string]string{"http.method": "POST"},
+			expected: false,
+		},
+		{
+			name:     "glob match",
+			rule:     &parsedRule{tag: "http.url", operator: ruleOperatorGlob, value: "/health*"},
+			tags:     map[string]string{"http.url": "/healthcheck"},
+			expected: true,
+		},
+		{
+			name:     "numeric range within bounds",
+			rule:     &parsedRule{tag: "http.status_code", operator: ruleOperatorNumericRange, min: 500, max: 599},
+			tags:     map[string]string{"http.status_code": "503"},
+			expected: true,
+		},
+		{
+			name:     "numeric range outside bounds",
+			rule:     &parsedRule{tag: "http.status_code", operator: ruleOperatorNumericRange, min: 500, max: 599},
+			tags:     map[string]string{"http.status_code": "200"},
+			expected: false,
+		},
+		{
+			name:     "numeric range malformed value",
+			rule:     &parsedRule{tag: "http.status_code", operator: ruleOperatorNumericRange, min: 500, max: 599},
+			tags:     map[string]string{"http.status_code": "not-a-number"},
+			expected: false,
+		},
+		{
+			name:     "missing tag",
+			rule:     &parsedRule{tag: "http.method", operator: ruleOperatorEquals, value: "GET"},
+			tags:     map[string]string{},
+			expected: false,
+		},
+		{
+			name:     "unknown operator",
+			rule:     &parsedRule{tag: "http.method", operator: "regex", value: "GET"},
+			tags:     map[string]string{"http.method": "GET"},
+			expected: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, ruleMatches(test.rule, test.tags))
+		})
+	}
+}
+
+func TestGetSamplingStrategyForSpanMatchesRule(t *testing.T) {
+	h := &strategyStore{logger: zap.NewNop()}
+	h.storedStrategies.Store(&storedStrategies{
+		defaultStrategy:   defaultStrategyResponse(),
+		serviceStrategies: map[string]*api_v2.SamplingStrategyResponse{},
+		serviceRules: map[string][]*parsedRule{
+			"svc": {
+				{
+					tag:      "http.status_code",
+					operator: ruleOperatorNumericRange,
+					min:      500,
+					max:      599,
+					strategy: &api_v2.SamplingStrategyResponse{
+						StrategyType: api_v2.SamplingStrategyType_PROBABILISTIC,
+						ProbabilisticSampling: &api_v2.ProbabilisticSamplingStrategy{
+							SamplingRate: 1,
+						},
+					},
+				},
+			},
+		},
+	})
+
+	resp, err := h.GetSamplingStrategyForSpan(context.Background(), "svc", map[string]string{"http.status_code": "503"})
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, resp.ProbabilisticSampling.SamplingRate)
+}
+
+func TestGetSamplingStrategyForSpanFallsBackWhenNoRuleMatches(t *testing.T) {
+	h := &strategyStore{logger: zap.NewNop()}
+	serviceResp := &api_v2.SamplingStrategyResponse{
+		StrategyType: api_v2.SamplingStrategyType_PROBABILISTIC,
+		ProbabilisticSampling: &api_v2.ProbabilisticSamplingStrategy{
+			SamplingRate: 0.25,
+		},
+	}
+	h.storedStrategies.Store(&storedStrategies{
+		defaultStrategy: defaultStrategyResponse(),
+		serviceStrategies: map[string]*api_v2.SamplingStrategyResponse{
+			"svc": serviceResp,
+		},
+		serviceRules: map[string][]*parsedRule{
+			"svc": {
+				{tag: "http.status_code", operator: ruleOperatorNumericRange, min: 500, max: 599},
+			},
+		},
+	})
+
+	resp, err := h.GetSamplingStrategyForSpan(context.Background(), "svc", map[string]string{"http.status_code": "200"})
+	require.NoError(t, err)
+	assert.Same(t, serviceResp, resp)
+}
@@ -20,13 +20,11 @@ import (
 	"encoding/gob"
 	"encoding/json"
 	"fmt"
-	"net/http"
-	"net/url"
-	"os"
 	"path/filepath"
 	"sync/atomic"
 	"time"
 
+	"github.com/fsnotify/fsnotify"
 	"go.uber.org/zap"
 
 	ss "github.com/jaegertracing/jaeger/cmd/collector/app/sampling/strategystore"
@@ -42,15 +40,33 @@ type strategyStore struct {
 
 	storedStrategies atomic.Value // holds *storedStrategies
 
+	loader     StrategyLoader
 	cancelFunc context.CancelFunc
 }
 
+// strategyLoaderCloser is implemented by StrategyLoaders that hold a resource (e.g. a gRPC
+// connection) that must be released when the strategy store is closed.
+type strategyLoaderCloser interface {
+	Close() error
+}
+
 type storedStrategies struct {
 	defaultStrategy   *api_v2.SamplingStrategyResponse
 	serviceStrategies map[string]*api_v2.SamplingStrategyResponse
-}
 
-type strategyLoader func() ([]byte, error)
+	// defaultRules and serviceRules are kept outside of the api_v2.SamplingStrategyResponse
+	// values above because that generated type has no field to carry them on the wire; they are
+	// only ever consulted locally, by GetSamplingStrategyForSpan.
+	defaultRules []*parsedRule
+	serviceRules map[string][]*parsedRule
+
+	// defaultOperationRateLimits and serviceOperationRateLimits hold rate-limiting strategies
+	// configured for individual operations. api_v2.OperationSamplingStrategy has no field for
+	// rate limiting either, so these are also only consulted locally, by
+	// GetSamplingStrategyForOperation.
+	defaultOperationRateLimits map[string]*api_v2.SamplingStrategyResponse
+	serviceOperationRateLimits map[string]map[string]*api_v2.SamplingStrategyResponse
+}
 
 // NewStrategyStore creates a strategy store that holds static sampling strategies.
 func NewStrategyStore(options Options, logger *zap.Logger) (ss.StrategyStore, error) {
@@ -66,15 +82,24 @@ func NewStrategyStore(options Options, logger *zap.Logger) (ss.StrategyStore, er
 		return h, nil
 	}
 
-	loadFn := h.samplingStrategyLoader(options.StrategiesFile)
-	strategies, err := loadStrategies(loadFn)
+	loader, err := newStrategyLoader(options.StrategiesFile)
+	if err != nil {
+		return nil, err
+	}
+	h.loader = loader
+
+	data, version, err := loader.Load(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	strategies, err := unmarshalStrategies(data)
 	if err != nil {
 		return nil, err
 	}
 	h.parseStrategies(strategies)
 
 	if options.ReloadInterval > 0 {
-		go h.autoUpdateStrategies(ctx, options.ReloadInterval, loadFn)
+		go h.autoUpdateStrategies(ctx, options.ReloadInterval, options.StrategiesFile, loader, version)
 	}
 	return h, nil
 }
@@ -90,109 +115,84 @@ func (h *strategyStore) GetSamplingStrategy(_ context.Context, serviceName strin
 	return ss.defaultStrategy, nil
 }
 
-// Close stops updating the strategies
+// Close stops updating the strategies and releases any resources held by the strategy loader.
 func (h *strategyStore) Close() {
 	h.cancelFunc()
-}
-
-func (h *strategyStore) downloadSamplingStrategies(url string) ([]byte, error) {
-	h.logger.Info("Downloading sampling strategies", zap.String("url", url))
-	resp, err := http.Get(url)
-	if err != nil {
-		return nil, fmt.Errorf("failed to download sampling strategies: %w", err)
-	}
-
-	defer resp.Body.Close()
-	buf := new(bytes.Buffer)
-	if _, err = buf.ReadFrom(resp.Body); err != nil {
-		return nil, fmt.Errorf("failed to read sampling strategies HTTP response body: %w", err)
-	}
-
-	if resp.StatusCode == http.StatusServiceUnavailable {
-		return nullJSON, nil
-	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf(
-			"receiving %s while downloading strategies file: %s",
-			resp.Status,
-			buf.String(),
-		)
+	if closer, ok := h.loader.(strategyLoaderCloser); ok {
+		if err := closer.Close(); err != nil {
+			h.logger.Error("failed to close sampling strategy loader", zap.Error(err))
+		}
 	}
-
-	return buf.Bytes(), nil
-}
-
-func isURL(str string) bool {
-	u, err := url.Parse(str)
-	return err == nil && u.Scheme != "" && u.Host != ""
 }
 
-func (h *strategyStore) samplingStrategyLoader(strategiesFile string) strategyLoader {
-	if isURL(strategiesFile) {
-		return func() ([]byte, error) {
-			return h.downloadSamplingStrategies(strategiesFile)
-		}
-	}
+// autoUpdateStrategies reloads the strategies whenever they change. File sources are watched with
+// fsnotify so edits take effect immediately instead of waiting up to interval; other sources have
+// no push mechanism of their own so they are only polled, relying on their StrategyLoader's
+// version token to skip reparsing when nothing changed. The ticker also runs for file sources as
+// a fallback in case the watch is not delivered, e.g. the file's directory is unwatchable.
+func (h *strategyStore) autoUpdateStrategies(ctx context.Context, interval time.Duration, strategiesFile string, loader StrategyLoader, lastVersion string) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
 
-	return func() ([]byte, error) {
-		h.logger.Info("Loading sampling strategies", zap.String("filename", strategiesFile))
-		currBytes, err := os.ReadFile(filepath.Clean(strategiesFile))
+	var events <-chan fsnotify.Event
+	if !isURL(strategiesFile) {
+		watcher, err := fsnotify.NewWatcher()
 		if err != nil {
-			return nil, fmt.Errorf("failed to read strategies file %s: %w", strategiesFile, err)
+			h.logger.Error("failed to create sampling strategies file watcher, relying on polling", zap.Error(err))
+		} else {
+			defer watcher.Close()
+			if err := watcher.Add(filepath.Dir(strategiesFile)); err != nil {
+				h.logger.Error("failed to watch sampling strategies file, relying on polling", zap.Error(err))
+			} else {
+				events = watcher.Events
+			}
 		}
-		return currBytes, nil
 	}
-}
 
-func (h *strategyStore) autoUpdateStrategies(ctx context.Context, interval time.Duration, loader strategyLoader) {
-	lastValue := string(nullJSON)
-	ticker := time.NewTicker(interval)
-	defer ticker.Stop()
 	for {
 		select {
+		case event, ok := <-events:
+			if !ok {
+				events = nil
+				continue
+			}
+			if filepath.Clean(event.Name) != filepath.Clean(strategiesFile) {
+				continue
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) == 0 {
+				continue
+			}
+			lastVersion = h.reloadSamplingStrategy(ctx, loader, lastVersion)
 		case <-ticker.C:
-			lastValue = h.reloadSamplingStrategy(loader, lastValue)
+			lastVersion = h.reloadSamplingStrategy(ctx, loader, lastVersion)
 		case <-ctx.Done():
 			return
 		}
 	}
 }
 
-func (h *strategyStore) reloadSamplingStrategy(loadFn strategyLoader, lastValue string) string {
-	newValue, err := loadFn()
+func (h *strategyStore) reloadSamplingStrategy(ctx context.Context, loader StrategyLoader, lastVersion string) string {
+	data, version, err := loader.Load(ctx, lastVersion)
 	if err != nil {
 		h.logger.Error("failed to re-load sampling strategies", zap.Error(err))
-		return lastValue
-	}
-	if lastValue == string(newValue) {
-		return lastValue
+		return lastVersion
 	}
-	if err := h.updateSamplingStrategy(newValue); err != nil {
-		h.logger.Error("failed to update sampling strategies", zap.Error(err))
-		return lastValue
-	}
-	return string(newValue)
-}
-
-func (h *strategyStore) updateSamplingStrategy(bytes []byte) error {
-	var strategies strategies
-	if err := json.Unmarshal(bytes, &strategies); err != nil {
-		return fmt.Errorf("failed to unmarshal sampling strategies: %w", err)
+	if version == lastVersion {
+		return lastVersion
 	}
-	h.parseStrategies(&strategies)
-	h.logger.Info("Updated sampling strategies:" + string(bytes))
-	return nil
-}
-
-// TODO good candidate for a global util function
-func loadStrategies(loadFn strategyLoader) (*strategies, error) {
-	strategyBytes, err := loadFn()
+	strategies, err := unmarshalStrategies(data)
 	if err != nil {
-		return nil, err
+		h.logger.Error("failed to unmarshal sampling strategies", zap.Error(err))
+		return lastVersion
 	}
+	h.parseStrategies(strategies)
+	h.logger.Info("Updated sampling strategies:" + string(data))
+	return version
+}
 
+func unmarshalStrategies(data []byte) (*strategies, error) {
 	var strategies *strategies
-	if err := json.Unmarshal(strategyBytes, &strategies); err != nil {
+	if err := json.Unmarshal(data, &strategies); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal strategies: %w", err)
 	}
 	return strategies, nil
@@ -206,6 +206,8 @@ func (h *strategyStore) parseStrategies(strategies *strategies) {
 	newStore := defaultStrategies()
 	if strategies.DefaultStrategy != nil {
 		newStore.defaultStrategy = h.parseServiceStrategies(strategies.DefaultStrategy)
+		newStore.defaultRules = h.parseRules(strategies.DefaultStrategy.Rules)
+		newStore.defaultOperationRateLimits = h.parseOperationRateLimits(strategies.DefaultStrategy.OperationStrategies)
 	}
 
 	merge := true
@@ -216,6 +218,8 @@ func (h *strategyStore) parseStrategies(strategies *strategies) {
 
 	for _, s := range strategies.ServiceStrategies {
 		newStore.serviceStrategies[s.Service] = h.parseServiceStrategies(s)
+		newStore.serviceRules[s.Service] = h.parseRules(s.Rules)
+		newStore.serviceOperationRateLimits[s.Service] = h.parseOperationRateLimits(s.OperationStrategies)
 
 		// Merge with the default operation strategies, because only merging with
 		// the default strategy has no effect on service strategies (the default strategy
@@ -285,17 +289,25 @@ func (h *strategyStore) parseServiceStrategies(strategy *serviceStrategy) *api_v
 	return resp
 }
 
+// parseOperationStrategy parses the strategy configured for a single operation into the
+// probabilistic form carried in the regular per-operation wire response.
+// api_v2.OperationSamplingStrategy only has a ProbabilisticSampling field, so a rate-limiting
+// operation strategy cannot be represented there; it is omitted from that response (falling back
+// to the service's per-operation default probability for callers using the regular wire
+// response) but is still honored by GetSamplingStrategyForOperation, which reads it from
+// storedStrategies.serviceOperationRateLimits (defaultOperationRateLimits for the default
+// strategy) instead of the wire type.
 func (h *strategyStore) parseOperationStrategy(
 	strategy *operationStrategy,
 	parent *api_v2.PerOperationSamplingStrategies,
 ) (s *api_v2.SamplingStrategyResponse, ok bool) {
 	s = h.parseStrategy(&strategy.strategy)
 	if s.StrategyType == api_v2.SamplingStrategyType_RATE_LIMITING {
-		// TODO OperationSamplingStrategy only supports probabilistic sampling
-		h.logger.Warn(
+		h.logger.Debug(
 			fmt.Sprintf(
-				"Operation strategies only supports probabilistic sampling at the moment,"+
-					"'%s' defaulting to probabilistic sampling with probability %f",
+				"'%s' uses rate-limiting sampling, which is only available via "+
+					"GetSamplingStrategyForOperation; the regular per-operation response "+
+					"defaults it to the service's probabilistic rate of %f",
 				strategy.Operation, parent.DefaultSamplingProbability),
 			zap.Any("strategy", strategy))
 		return nil, false
@@ -0,0 +1,81 @@
+// Copyright (c) 2026 The Jaeger Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package static
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewStrategyStoreReloadsOnFileWatchEvent(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "strategies.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"default_strategy":{"type":"probabilistic","param":0.1}}`), 0o600))
+
+	// A long ReloadInterval proves the update below is delivered by the fsnotify watch, not the
+	// ticker fallback.
+	store, err := NewStrategyStore(Options{StrategiesFile: path, ReloadInterval: time.Hour}, zap.NewNop())
+	require.NoError(t, err)
+	defer store.Close()
+
+	resp, err := store.GetSamplingStrategy(context.Background(), "unknown-service")
+	require.NoError(t, err)
+	assert.Equal(t, 0.1, resp.ProbabilisticSampling.SamplingRate)
+
+	// A write to an unrelated file in the same watched directory must not trigger a reload.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "unrelated.json"), []byte(`{}`), 0o600))
+	time.Sleep(100 * time.Millisecond)
+	resp, err = store.GetSamplingStrategy(context.Background(), "unknown-service")
+	require.NoError(t, err)
+	assert.Equal(t, 0.1, resp.ProbabilisticSampling.SamplingRate)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"default_strategy":{"type":"probabilistic","param":0.9}}`), 0o600))
+
+	require.Eventually(t, func() bool {
+		resp, err := store.GetSamplingStrategy(context.Background(), "unknown-service")
+		return err == nil && resp.ProbabilisticSampling.SamplingRate == 0.9
+	}, 5*time.Second, 20*time.Millisecond, "expected a write to the watched strategies file to trigger a reload")
+}
+
+func TestAutoUpdateStrategiesPollsSourcesThatCannotBeWatched(t *testing.T) {
+	// URL sources take the same "no watcher" path as a failed fsnotify.NewWatcher(): isURL
+	// short-circuits autoUpdateStrategies before it ever touches fsnotify, so reloads can only
+	// come from the ticker. This exercises that ticker-only fallback end to end.
+	param := 0.1
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprintf(w, `{"default_strategy":{"type":"probabilistic","param":%v}}`, param)
+	}))
+	defer server.Close()
+
+	store, err := NewStrategyStore(Options{StrategiesFile: server.URL, ReloadInterval: 20 * time.Millisecond}, zap.NewNop())
+	require.NoError(t, err)
+	defer store.Close()
+
+	param = 0.42
+	require.Eventually(t, func() bool {
+		resp, err := store.GetSamplingStrategy(context.Background(), "unknown-service")
+		return err == nil && resp.ProbabilisticSampling.SamplingRate == 0.42
+	}, 5*time.Second, 20*time.Millisecond, "expected ticker-driven polling to reload a URL source")
+}